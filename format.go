@@ -0,0 +1,54 @@
+// Copyright ©2015 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plot
+
+import (
+	"fmt"
+
+	"github.com/mison201/plot/vg"
+	"github.com/mison201/plot/vg/draw"
+)
+
+// FormatFactory creates a vg.CanvasWriterTo of a particular image
+// format for the given width and height.
+type FormatFactory func(w, h vg.Length) (vg.CanvasWriterTo, error)
+
+// formats holds the registered output formats, keyed by the file
+// extension (without the leading dot) used to select them.
+var formats = make(map[string]FormatFactory)
+
+func init() {
+	for _, format := range []string{"eps", "jpg", "jpeg", "pdf", "png", "svg", "tif", "tiff"} {
+		RegisterFormat(format, func(f string) FormatFactory {
+			return func(w, h vg.Length) (vg.CanvasWriterTo, error) {
+				return draw.NewFormattedCanvas(w, h, f)
+			}
+		}(format))
+	}
+}
+
+// RegisterFormat makes an output format identified by name (the
+// lower-case file extension used to select it, without the leading
+// dot, e.g. "png") available to WriterTo and Save.  Registering a
+// name that is already registered replaces the existing factory,
+// which allows callers to override one of the built-in formats.
+//
+// This lets downstream packages provide their own vg.CanvasWriterTo
+// implementations — for example a terminal renderer producing ANSI
+// or braille output, or a headless image backend for server-side
+// rendering — without needing to fork this package.
+func RegisterFormat(name string, factory FormatFactory) {
+	formats[name] = factory
+}
+
+// canvasFor looks up the registered factory for format and uses it
+// to create a new canvas of the given size.
+func canvasFor(w, h vg.Length, format string) (vg.CanvasWriterTo, error) {
+	factory, ok := formats[format]
+	if !ok {
+		return nil, fmt.Errorf("plot: unsupported format: %q", format)
+	}
+	return factory(w, h)
+}
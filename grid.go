@@ -0,0 +1,275 @@
+// Copyright ©2015 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plot
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/mison201/plot/vg"
+	"github.com/mison201/plot/vg/draw"
+)
+
+// Grid arranges a number of plots into an m×n grid on a single
+// draw.Canvas.  Cells may be left empty, and adjacent cells may be
+// told to share an axis so that the shared axis is only drawn once,
+// collapsing the inner tick labels and the width/height they would
+// otherwise consume.
+//
+// The zero value is not usable; use NewGrid.
+type Grid struct {
+	rows, cols int
+
+	plots  map[gridCell]*Plot
+	aspect map[gridCell]float64
+
+	// shareXCol and shareYRow record the columns and rows for
+	// which ShareX and ShareY have been called.
+	shareXCol map[int]bool
+	shareYRow map[int]bool
+}
+
+// gridCell identifies a single position in a Grid.
+type gridCell struct {
+	row, col int
+}
+
+// NewGrid returns a new, empty Grid with the given number of
+// rows and columns.
+func NewGrid(rows, cols int) *Grid {
+	return &Grid{
+		rows:      rows,
+		cols:      cols,
+		plots:     make(map[gridCell]*Plot),
+		aspect:    make(map[gridCell]float64),
+		shareXCol: make(map[int]bool),
+		shareYRow: make(map[int]bool),
+	}
+}
+
+// Add places p at the given row and column, replacing any plot
+// that was already there.  Rows and columns are numbered from 0,
+// with row 0 at the top.
+func (g *Grid) Add(row, col int, p *Plot) {
+	g.plots[gridCell{row, col}] = p
+}
+
+// SetAspect sets the height-to-width aspect ratio to use for the
+// plot at row, col when computing that cell's height; the cell is
+// never made taller than its row, and the row's height is not
+// changed.  A ratio of zero (the default) lets the cell take the
+// row's natural height.
+func (g *Grid) SetAspect(row, col int, ratio float64) {
+	g.aspect[gridCell{row, col}] = ratio
+}
+
+// ShareX causes every plot in the given column to share a single
+// X axis, drawn only beneath the bottom-most occupied cell; the
+// X axis of the other cells in the column is hidden so that their
+// inner tick labels collapse.
+func (g *Grid) ShareX(col int) {
+	g.shareXCol[col] = true
+}
+
+// ShareY is like ShareX, but shares a single Y axis down the given
+// row, drawn only to the left of the left-most occupied cell.
+func (g *Grid) ShareY(row int) {
+	g.shareYRow[row] = true
+}
+
+// axisWidth returns the width that p's Y axes will consume when
+// drawn, mirroring the computation Plot.Draw performs.
+func axisWidth(p *Plot) vg.Length {
+	p.Ys[0].sanitizeRange()
+	w := verticalAxis{*p.Ys[0]}.size()
+	if len(p.Ys) > 1 {
+		// Plot.Draw reserves a fixed right margin for the second Y axis.
+		w += 72
+	}
+	return w
+}
+
+// axisHeight returns the height that p's X axis and title will
+// consume when drawn, mirroring the computation Plot.Draw performs.
+func axisHeight(p *Plot) vg.Length {
+	p.X.sanitizeRange()
+	h := horizontalAxis{*p.X}.size()
+	if p.Title.Text != "" {
+		h += p.Title.Height(p.Title.Text) - p.Title.Font.Extents().Descent
+		h += p.Title.Padding
+	}
+	return h
+}
+
+// lastOccupiedRow returns the largest row index in col that has a
+// plot, or -1 if the column is empty.
+func (g *Grid) lastOccupiedRow(col int) int {
+	last := -1
+	for cell := range g.plots {
+		if cell.col == col && cell.row > last {
+			last = cell.row
+		}
+	}
+	return last
+}
+
+// firstOccupiedCol returns the smallest column index in row that
+// has a plot, or -1 if the row is empty.
+func (g *Grid) firstOccupiedCol(row int) int {
+	first := -1
+	for cell := range g.plots {
+		if cell.row == row && (first == -1 || cell.col < first) {
+			first = cell.col
+		}
+	}
+	return first
+}
+
+// Draw lays out and draws every plot in the grid to c, dividing the
+// canvas into g.rows×g.cols cells.  Cells sharing an axis only pay
+// for that axis' width or height once.
+func (g *Grid) Draw(c draw.Canvas) {
+	size := c.Size()
+
+	// colAxisW[col] and rowAxisH[row] hold the extra width/height
+	// that the Y and X axes still being drawn in that column/row
+	// require, over and above the data area itself.  Cells whose
+	// axis is hidden by ShareX/ShareY do not contribute.
+	colAxisW := make([]vg.Length, g.cols)
+	rowAxisH := make([]vg.Length, g.rows)
+	for cell, p := range g.plots {
+		first := g.firstOccupiedCol(cell.row)
+		last := g.lastOccupiedRow(cell.col)
+		if w := axisWidth(p); !(g.shareYRow[cell.row] && cell.col != first) && w > colAxisW[cell.col] {
+			colAxisW[cell.col] = w
+		}
+		if h := axisHeight(p); !(g.shareXCol[cell.col] && cell.row != last) && h > rowAxisH[cell.row] {
+			rowAxisH[cell.row] = h
+		}
+	}
+
+	var axisWSum, axisHSum vg.Length
+	for _, w := range colAxisW {
+		axisWSum += w
+	}
+	for _, h := range rowAxisH {
+		axisHSum += h
+	}
+	dataW := (size.X - axisWSum) / vg.Length(g.cols)
+	dataH := (size.Y - axisHSum) / vg.Length(g.rows)
+
+	colW := make([]vg.Length, g.cols)
+	rowH := make([]vg.Length, g.rows)
+	for col := 0; col < g.cols; col++ {
+		colW[col] = colAxisW[col] + dataW
+	}
+	for row := 0; row < g.rows; row++ {
+		rowH[row] = rowAxisH[row] + dataH
+	}
+
+	x := c.Min.X
+	for col := 0; col < g.cols; col++ {
+		y := c.Max.Y
+		last := g.lastOccupiedRow(col)
+		for row := 0; row < g.rows; row++ {
+			y -= rowH[row]
+			p, ok := g.plots[gridCell{row, col}]
+			if !ok {
+				continue
+			}
+			first := g.firstOccupiedCol(row)
+
+			h := rowH[row]
+			if ratio := g.aspect[gridCell{row, col}]; ratio > 0 {
+				h = colW[col] * vg.Length(ratio)
+				if h > rowH[row] {
+					h = rowH[row]
+				}
+			}
+			cell := draw.Canvas{
+				Canvas: c.Canvas,
+				Rectangle: vg.Rectangle{
+					Min: vg.Point{X: x, Y: y},
+					Max: vg.Point{X: x + colW[col], Y: y + h},
+				},
+			}
+
+			// HideX/HideY mutate p's axes in place; snapshot and
+			// restore them around Draw so that calling Draw again
+			// — with different ShareX/ShareY settings, or after p
+			// is removed from the grid — doesn't leave p's axes
+			// stuck hidden.
+			hideX := g.shareXCol[col] && row != last
+			hideY := g.shareYRow[row] && col != first
+			var savedX Axis
+			var savedYs []Axis
+			if hideX {
+				savedX = *p.X
+				p.HideX()
+			}
+			if hideY {
+				savedYs = make([]Axis, len(p.Ys))
+				for i, pY := range p.Ys {
+					savedYs[i] = *pY
+				}
+				p.HideY()
+			}
+
+			p.Draw(cell)
+
+			if hideX {
+				*p.X = savedX
+			}
+			if hideY {
+				for i, pY := range p.Ys {
+					*pY = savedYs[i]
+				}
+			}
+		}
+		x += colW[col]
+	}
+}
+
+// WriterTo returns an io.WriterTo that will write the grid, laid
+// out on a w×h canvas, in the given image format.  The supported
+// formats are the same as Plot.WriterTo.
+func (g *Grid) WriterTo(w, h vg.Length, format string) (io.WriterTo, error) {
+	c, err := canvasFor(w, h, format)
+	if err != nil {
+		return nil, err
+	}
+	g.Draw(draw.New(c))
+	return c, nil
+}
+
+// Save saves the grid to an image file, the format of which is
+// determined by the file extension.  The supported extensions are
+// the same as Plot.Save.
+func (g *Grid) Save(w, h vg.Length, file string) (err error) {
+	f, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		e := f.Close()
+		if err == nil {
+			err = e
+		}
+	}()
+
+	format := strings.ToLower(filepath.Ext(file))
+	if len(format) != 0 {
+		format = format[1:]
+	}
+	c, err := g.WriterTo(w, h, format)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.WriteTo(f)
+	return err
+}
@@ -0,0 +1,61 @@
+// Copyright ©2015 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plot
+
+import "github.com/mison201/plot/vg/draw"
+
+// Live wraps a Plot with its most recently computed Layout, so that
+// callers pushing new samples at high frequency — dashboards and
+// terminal renderers, for example — can redraw just the data area
+// without recomputing axis extents and padding on every frame.
+//
+// The zero value is not usable; use NewLive.
+type Live struct {
+	// Plot is the wrapped plot.
+	Plot *Plot
+
+	l Layout
+}
+
+// NewLive returns a Live wrapping p.  Call Draw once before using
+// Update or RedrawData, to establish the initial layout.
+func NewLive(p *Plot) *Live {
+	return &Live{Plot: p}
+}
+
+// Draw lays out and fully draws the wrapped plot to c, caching the
+// resulting Layout for later calls to Update and RedrawData.
+func (lv *Live) Draw(c draw.Canvas) {
+	lv.l = lv.Plot.layout(c)
+	lv.Plot.drawAxes(c, lv.l)
+	lv.Plot.drawData(lv.l.dataC)
+}
+
+// DataCanvas returns the data canvas computed by the most recent
+// call to Draw.
+func (lv *Live) DataCanvas() draw.Canvas {
+	return lv.l.dataC
+}
+
+// Update adds plotter to the wrapped plot and redraws the cached
+// data area.  It does not recompute axis extents, so plotter's data
+// range should already be covered by the plot's existing axes.
+func (lv *Live) Update(plotter Plotter) {
+	lv.Plot.Add(plotter)
+	lv.RedrawData(lv.l.dataC)
+}
+
+// RedrawData redraws the wrapped plot's data to c — typically the
+// canvas returned by DataCanvas — without recomputing axis extents
+// or padding.  Call it after mutating the data behind a plotter
+// already added to the plot, for example after appending points to
+// a plotter.XYs that a line plotter draws from.
+func (lv *Live) RedrawData(c draw.Canvas) {
+	if lv.Plot.BackgroundColor != nil {
+		c.SetColor(lv.Plot.BackgroundColor)
+		c.Fill(c.Rectangle.Path())
+	}
+	lv.Plot.drawData(c)
+}
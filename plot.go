@@ -160,74 +160,112 @@ func (p *Plot) AddWithAxis(yAxis int, d Plotter) {
 	p.plotters = append(p.plotters, plotterAxis{Plotter: d, yAxis: yAxis})
 }
 
-// Draw draws a plot to a draw.Canvas.
-//
-// Plotters are drawn in the order in which they were
-// added to the plot.  Plotters that  implement the
-// GlyphBoxer interface will have their GlyphBoxes
-// taken into account when padding the plot so that
-// none of their glyphs are clipped.
-func (p *Plot) Draw(c draw.Canvas) {
-	var marginRight vg.Length
-	marginRight = 0
-	if p.BackgroundColor != nil {
-		c.SetColor(p.BackgroundColor)
-		c.Fill(c.Rectangle.Path())
-	}
+// Layout holds the axis and canvas geometry computed for a Plot by
+// layout.  It is kept around by Live so that a plot's data can be
+// redrawn without recomputing axis extents and padding.
+type Layout struct {
+	// titleTop is the Y coordinate the title, if any, is anchored
+	// at, in the canvas layout was computed for.
+	titleTop vg.Length
+
+	// area is the canvas remaining once the title's height has
+	// been reserved; axes, the legend and the data area are all
+	// derived from it.
+	area draw.Canvas
+
+	x  horizontalAxis
+	y0 verticalAxis
+	y1 *verticalAxis
+
+	ywidth, xheight, marginRight vg.Length
+
+	// dataC is the padded canvas that plotters draw into.
+	dataC draw.Canvas
+}
+
+// layout computes the geometry needed to draw p to c, without
+// drawing anything.
+func (p *Plot) layout(c draw.Canvas) Layout {
+	var l Layout
+	l.titleTop = c.Max.Y
 	if p.Title.Text != "" {
-		c.FillText(p.Title.TextStyle, vg.Point{c.Center().X, c.Max.Y}, -0.5, -1, p.Title.Text)
 		c.Max.Y -= p.Title.Height(p.Title.Text) - p.Title.Font.Extents().Descent
 		c.Max.Y -= p.Title.Padding
 	}
 	if len(p.Ys) > 1 {
-		marginRight = -72
+		l.marginRight = -72
 	}
-	p.X.sanitizeRange()
-	x := horizontalAxis{*p.X}
-	xheight := x.size()
 
-	ywidth := vg.Length(0)
+	p.X.sanitizeRange()
+	l.x = horizontalAxis{*p.X}
+	l.xheight = l.x.size()
 
 	p.Ys[0].sanitizeRange()
-	y := verticalAxis{*p.Ys[0]}
+	l.y0 = verticalAxis{*p.Ys[0]}
+	l.ywidth = l.y0.size()
+
+	if len(p.Ys) > 1 {
+		p.Ys[1].sanitizeRange()
+		y1 := verticalAxis{*p.Ys[1]}
+		y1.AlignRight = true
+		l.y1 = &y1
+	}
+
+	l.area = c
+	l.dataC = padY(p, padX(p, draw.Crop(c, l.ywidth, l.marginRight, l.xheight, 0)))
+	return l
+}
+
+// drawAxes paints the background, title, axes and legend of p to c,
+// using the geometry computed by layout.
+func (p *Plot) drawAxes(c draw.Canvas, l Layout) {
+	if p.BackgroundColor != nil {
+		c.SetColor(p.BackgroundColor)
+		c.Fill(c.Rectangle.Path())
+	}
+	if p.Title.Text != "" {
+		c.FillText(p.Title.TextStyle, vg.Point{c.Center().X, l.titleTop}, -0.5, -1, p.Title.Text)
+	}
 
-	y.draw(padY(p, draw.Crop(c, ywidth, 0, xheight, 0)))
-	ywidth += y.size()
+	l.y0.draw(padY(p, draw.Crop(l.area, 0, 0, l.xheight, 0)))
+	l.x.draw(padX(p, draw.Crop(l.area, l.ywidth, l.marginRight, 0, 0)))
 
-	x.draw(padX(p, draw.Crop(c, ywidth, marginRight, 0, 0)))
+	p.Legend.draw(draw.Crop(draw.Crop(l.area, l.ywidth, l.marginRight, 0, 0), 0, 0, l.xheight, 0))
+	if l.y1 != nil {
+		// Crop relative to the area's own size, rather than its
+		// absolute position, so Draw can be called on a
+		// sub-canvas such as the one plotter.Inset embeds a
+		// child plot into.
+		l.y1.draw(padY(p, draw.Crop(l.area, l.area.Size().X-135, 0, l.xheight, 0)))
+	}
+}
 
-	dataC := padY(p, padX(p, draw.Crop(c, ywidth, marginRight, xheight, 0)))
+// drawData draws p's plotters, in the order they were added, to
+// dataC.
+func (p *Plot) drawData(dataC draw.Canvas) {
 	for _, data := range p.plotters {
 		data.Plotter.Plot(dataC, p, p.X, p.Ys[data.yAxis])
 	}
+}
 
-	p.Legend.draw(draw.Crop(draw.Crop(c, ywidth, marginRight, 0, 0), 0, 0, xheight, 0))
-	if len(p.Ys) > 1 {
-		p.Ys[1].sanitizeRange()
-		y = verticalAxis{*p.Ys[1]}
-		y.AlignRight = true
-		y.draw(padY(p, draw.Crop(c, c.Rectangle.Max.X-135, 0, xheight, 0)))
-	}
+// Draw draws a plot to a draw.Canvas.
+//
+// Plotters are drawn in the order in which they were
+// added to the plot.  Plotters that  implement the
+// GlyphBoxer interface will have their GlyphBoxes
+// taken into account when padding the plot so that
+// none of their glyphs are clipped.
+func (p *Plot) Draw(c draw.Canvas) {
+	l := p.layout(c)
+	p.drawAxes(c, l)
+	p.drawData(l.dataC)
 }
 
 // DataCanvas returns a new draw.Canvas that
 // is the subset of the given draw area into which
 // the plot data will be drawn.
 func (p *Plot) DataCanvas(da draw.Canvas) draw.Canvas {
-	if p.Title.Text != "" {
-		da.Max.Y -= p.Title.Height(p.Title.Text) - p.Title.Font.Extents().Descent
-		da.Max.Y -= p.Title.Padding
-	}
-	p.X.sanitizeRange()
-	x := horizontalAxis{*p.X}
-
-	ywidth := vg.Length(0)
-	for _, pY := range p.Ys {
-		pY.sanitizeRange()
-		y := verticalAxis{*pY}
-		ywidth += y.size()
-	}
-	return padY(p, padX(p, draw.Crop(da, ywidth, x.size(), 0, 0)))
+	return p.layout(da).dataC
 }
 
 // DrawGlyphBoxes draws red outlines around the plot's
@@ -491,11 +529,13 @@ func (p *Plot) NominalY(yn int, names ...string) {
 // WriterTo returns an io.WriterTo that will write the plot as
 // the specified image format.
 //
-// Supported formats are:
+// The built-in formats are:
 //
 //  eps, jpg|jpeg, pdf, png, svg, and tif|tiff.
+//
+// Additional formats may be made available with RegisterFormat.
 func (p *Plot) WriterTo(w, h vg.Length, format string) (io.WriterTo, error) {
-	c, err := draw.NewFormattedCanvas(w, h, format)
+	c, err := canvasFor(w, h, format)
 	if err != nil {
 		return nil, err
 	}
@@ -506,9 +546,11 @@ func (p *Plot) WriterTo(w, h vg.Length, format string) (io.WriterTo, error) {
 // Save saves the plot to an image file.  The file format is determined
 // by the extension.
 //
-// Supported extensions are:
+// The built-in extensions are:
 //
 //  .eps, .jpg, .jpeg, .pdf, .png, .svg, .tif and .tiff.
+//
+// Additional extensions may be made available with RegisterFormat.
 func (p *Plot) Save(w, h vg.Length, file string) (err error) {
 	f, err := os.Create(file)
 	if err != nil {
@@ -0,0 +1,282 @@
+// Copyright ©2015 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"errors"
+	"math"
+
+	"github.com/mison201/plot"
+	"github.com/mison201/plot/vg"
+	"github.com/mison201/plot/vg/draw"
+)
+
+// Axis identifies a direction along which Annotations may move a
+// label to resolve an overlap.
+type Axis int
+
+const (
+	// AxisVertical nudges labels up or down.
+	AxisVertical Axis = iota
+	// AxisHorizontal nudges labels left or right.
+	AxisHorizontal
+)
+
+// Annotation is a single text label anchored at a data point.
+type Annotation struct {
+	// X and Y are the data coordinates the label points at.
+	X, Y float64
+
+	// Text is the label text.
+	Text string
+
+	// Offset is the offset, in canvas units, from the anchor
+	// point to the label's initial position, before collision
+	// resolution runs.
+	Offset vg.Point
+
+	// TextStyle is the style used to draw Text.
+	draw.TextStyle
+}
+
+// Annotations implements the plot.Plotter, plot.GlyphBoxer and
+// plot.DataRanger interfaces, drawing a set of text labels anchored
+// to data points.  Labels that would otherwise overlap each other,
+// or run off the edge of the plot, are nudged along Axis until they
+// no longer do or a fixed number of resolution passes have run.
+type Annotations struct {
+	Annotations []Annotation
+
+	// Padding is added around each label's text when computing
+	// its bounding box, both for collision resolution and for
+	// the box reported by GlyphBoxes.
+	Padding vg.Length
+
+	// LeaderLineStyle, if its Width is non-zero, is used to draw
+	// a line from the data point to the (possibly nudged) label.
+	LeaderLineStyle draw.LineStyle
+
+	// Axis selects the direction labels are nudged in to resolve
+	// collisions.  The default, AxisVertical, is appropriate for
+	// labels arranged along a shared X value.
+	Axis Axis
+
+	// lastCanvas and haveCanvas cache the canvas passed to the
+	// most recent call to Plot, so that GlyphBoxes — which is
+	// called by Plot.Draw's layout step before Plot ever runs —
+	// can still compute real, collision-resolved boxes instead of
+	// falling back to an unresolved estimate on every redraw after
+	// the first.
+	lastCanvas draw.Canvas
+	haveCanvas bool
+}
+
+// NewAnnotations returns a new Annotations with one label per point
+// in xys, using a default text style.  len(labels) must equal
+// xys.Len().
+func NewAnnotations(xys XYer, labels []string) (*Annotations, error) {
+	if xys.Len() != len(labels) {
+		return nil, errors.New("plotter: annotations and labels have different lengths")
+	}
+	font, err := vg.MakeFont(plot.DefaultFont, 10)
+	if err != nil {
+		return nil, err
+	}
+	style := draw.TextStyle{Font: font}
+
+	a := &Annotations{Padding: vg.Points(2)}
+	for i := 0; i < xys.Len(); i++ {
+		x, y := xys.XY(i)
+		a.Annotations = append(a.Annotations, Annotation{
+			X:         x,
+			Y:         y,
+			Text:      labels[i],
+			TextStyle: style,
+		})
+	}
+	return a, nil
+}
+
+// Plot implements the plot.Plotter interface.
+func (a *Annotations) Plot(c draw.Canvas, plt *plot.Plot, x, y *plot.Axis) {
+	a.lastCanvas, a.haveCanvas = c, true
+
+	anchors, boxes := a.place(c, true, plt, x, y)
+	for i, lbl := range a.Annotations {
+		box := boxes[i]
+		if a.LeaderLineStyle.Width > 0 {
+			c.StrokeLine2(a.LeaderLineStyle,
+				anchors[i].X, anchors[i].Y,
+				box.Center().X, box.Center().Y)
+		}
+		c.FillText(lbl.TextStyle, vg.Point{X: box.Min.X + a.Padding, Y: box.Min.Y + a.Padding}, 0, 0, lbl.Text)
+	}
+}
+
+// place computes the anchor point and final, collision-resolved
+// bounding box of every label in canvas coordinates.  If haveCanvas
+// is false (GlyphBoxes has never seen a canvas from a prior call to
+// Plot), resolution against other labels and the plot edge is
+// skipped and each label's raw Offset+Padding box is returned —
+// still safe from clipping, just not yet separated from its
+// neighbors.
+func (a *Annotations) place(c draw.Canvas, haveCanvas bool, plt *plot.Plot, x, y *plot.Axis) (anchors []vg.Point, boxes []vg.Rectangle) {
+	anchors = make([]vg.Point, len(a.Annotations))
+	boxes = make([]vg.Rectangle, len(a.Annotations))
+
+	var trX, trY func(float64) vg.Length
+	if haveCanvas {
+		trX, trY = plt.Transforms(&c, x, y)
+	}
+	for i, lbl := range a.Annotations {
+		var anchor vg.Point
+		if haveCanvas {
+			anchor = vg.Point{X: trX(lbl.X), Y: trY(lbl.Y)}
+		}
+		anchors[i] = anchor
+
+		rect := lbl.TextStyle.Rectangle(lbl.Text)
+		rect = pad(offset(rect, vg.Point{
+			X: anchor.X + lbl.Offset.X,
+			Y: anchor.Y + lbl.Offset.Y,
+		}), a.Padding)
+		boxes[i] = rect
+	}
+
+	if haveCanvas {
+		a.resolve(boxes, c.Rectangle)
+	}
+	return anchors, boxes
+}
+
+// resolve repeatedly clamps each box to bound and nudges overlapping
+// boxes apart along a.Axis, until a pass makes no further changes or
+// a fixed number of passes have run.
+func (a *Annotations) resolve(boxes []vg.Rectangle, bound vg.Rectangle) {
+	const maxPasses = 50
+	for pass := 0; pass < maxPasses; pass++ {
+		moved := a.clampAll(boxes, bound)
+		if a.separateAll(boxes) {
+			moved = true
+		}
+		if !moved {
+			return
+		}
+	}
+}
+
+// clampAll slides any box that extends past bound back inside it,
+// along a.Axis, reporting whether any box moved.
+func (a *Annotations) clampAll(boxes []vg.Rectangle, bound vg.Rectangle) bool {
+	moved := false
+	for i := range boxes {
+		if a.Axis == AxisHorizontal {
+			if d := bound.Min.X - boxes[i].Min.X; d > 0 {
+				boxes[i] = offset(boxes[i], vg.Point{X: d})
+				moved = true
+			}
+			if d := boxes[i].Max.X - bound.Max.X; d > 0 {
+				boxes[i] = offset(boxes[i], vg.Point{X: -d})
+				moved = true
+			}
+			continue
+		}
+		if d := bound.Min.Y - boxes[i].Min.Y; d > 0 {
+			boxes[i] = offset(boxes[i], vg.Point{Y: d})
+			moved = true
+		}
+		if d := boxes[i].Max.Y - bound.Max.Y; d > 0 {
+			boxes[i] = offset(boxes[i], vg.Point{Y: -d})
+			moved = true
+		}
+	}
+	return moved
+}
+
+// separateAll, for every ordered pair of overlapping boxes i and j,
+// moves box i toward smaller and box j toward larger coordinates
+// along a.Axis, each by half the distance from i's lower edge to j's
+// upper edge, reporting whether any box moved.
+func (a *Annotations) separateAll(boxes []vg.Rectangle) bool {
+	moved := false
+	for i := range boxes {
+		for j := range boxes {
+			if i == j || !overlaps(boxes[i], boxes[j]) {
+				continue
+			}
+			if a.Axis == AxisHorizontal {
+				d := (boxes[j].Max.X - boxes[i].Min.X) / 2
+				boxes[i] = offset(boxes[i], vg.Point{X: -d})
+				boxes[j] = offset(boxes[j], vg.Point{X: d})
+			} else {
+				d := (boxes[j].Max.Y - boxes[i].Min.Y) / 2
+				boxes[i] = offset(boxes[i], vg.Point{Y: -d})
+				boxes[j] = offset(boxes[j], vg.Point{Y: d})
+			}
+			moved = true
+		}
+	}
+	return moved
+}
+
+// GlyphBoxes implements the plot.GlyphBoxer interface, returning the
+// final, post-resolution bounding box of each label.  Plot.Draw
+// calls this before Annotations.Plot ever runs, so the first time a
+// given Annotations is drawn, the box returned here is only an
+// Offset+Padding estimate, not yet separated from its neighbors;
+// every call after the first reuses the canvas from the prior draw
+// and so is fully resolved.
+func (a *Annotations) GlyphBoxes(plt *plot.Plot, x, y *plot.Axis) []plot.GlyphBox {
+	anchors, rects := a.place(a.lastCanvas, a.haveCanvas, plt, x, y)
+	boxes := make([]plot.GlyphBox, len(a.Annotations))
+	for i, lbl := range a.Annotations {
+		// place returns absolute canvas boxes (anchor baked in), but
+		// GlyphBox.Rectangle must be relative to the glyph location:
+		// padX/padY add it to a second, freshly-computed position.
+		boxes[i] = plot.GlyphBox{
+			X:         x.Norm(lbl.X),
+			Y:         y.Norm(lbl.Y),
+			Rectangle: offset(rects[i], vg.Point{X: -anchors[i].X, Y: -anchors[i].Y}),
+		}
+	}
+	return boxes
+}
+
+// DataRange implements the plot.DataRanger interface.
+func (a *Annotations) DataRange() (xmin, xmax, ymin, ymax float64) {
+	xmin, ymin = math.Inf(1), math.Inf(1)
+	xmax, ymax = math.Inf(-1), math.Inf(-1)
+	for _, lbl := range a.Annotations {
+		xmin = math.Min(xmin, lbl.X)
+		xmax = math.Max(xmax, lbl.X)
+		ymin = math.Min(ymin, lbl.Y)
+		ymax = math.Max(ymax, lbl.Y)
+	}
+	return xmin, xmax, ymin, ymax
+}
+
+// offset translates r by d.
+func offset(r vg.Rectangle, d vg.Point) vg.Rectangle {
+	r.Min.X += d.X
+	r.Max.X += d.X
+	r.Min.Y += d.Y
+	r.Max.Y += d.Y
+	return r
+}
+
+// pad grows r by p on every side.
+func pad(r vg.Rectangle, p vg.Length) vg.Rectangle {
+	r.Min.X -= p
+	r.Min.Y -= p
+	r.Max.X += p
+	r.Max.Y += p
+	return r
+}
+
+// overlaps reports whether a and b intersect.
+func overlaps(a, b vg.Rectangle) bool {
+	return a.Min.X < b.Max.X && a.Max.X > b.Min.X &&
+		a.Min.Y < b.Max.Y && a.Max.Y > b.Min.Y
+}
@@ -0,0 +1,104 @@
+// Copyright ©2015 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"testing"
+
+	"github.com/mison201/plot/vg"
+)
+
+func TestAnnotationsClampAll(t *testing.T) {
+	bound := vg.Rectangle{Min: vg.Point{X: 0, Y: 0}, Max: vg.Point{X: 100, Y: 100}}
+	cases := []struct {
+		name string
+		axis Axis
+		box  vg.Rectangle
+		want vg.Rectangle
+	}{
+		{
+			name: "inside bound is untouched",
+			axis: AxisVertical,
+			box:  vg.Rectangle{Min: vg.Point{X: 10, Y: 10}, Max: vg.Point{X: 20, Y: 20}},
+			want: vg.Rectangle{Min: vg.Point{X: 10, Y: 10}, Max: vg.Point{X: 20, Y: 20}},
+		},
+		{
+			name: "below the bottom edge is nudged up",
+			axis: AxisVertical,
+			box:  vg.Rectangle{Min: vg.Point{X: 10, Y: -10}, Max: vg.Point{X: 20, Y: 0}},
+			want: vg.Rectangle{Min: vg.Point{X: 10, Y: 0}, Max: vg.Point{X: 20, Y: 10}},
+		},
+		{
+			name: "past the right edge is nudged left under AxisHorizontal",
+			axis: AxisHorizontal,
+			box:  vg.Rectangle{Min: vg.Point{X: 90, Y: 10}, Max: vg.Point{X: 110, Y: 20}},
+			want: vg.Rectangle{Min: vg.Point{X: 80, Y: 10}, Max: vg.Point{X: 100, Y: 20}},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			a := &Annotations{Axis: c.axis}
+			boxes := []vg.Rectangle{c.box}
+			a.clampAll(boxes, bound)
+			if boxes[0] != c.want {
+				t.Errorf("clampAll(%v) = %v, want %v", c.box, boxes[0], c.want)
+			}
+		})
+	}
+}
+
+func TestAnnotationsSeparateAll(t *testing.T) {
+	t.Run("overlapping boxes are pushed apart", func(t *testing.T) {
+		a := &Annotations{Axis: AxisVertical}
+		boxes := []vg.Rectangle{
+			{Min: vg.Point{X: 0, Y: 0}, Max: vg.Point{X: 10, Y: 10}},
+			{Min: vg.Point{X: 0, Y: 5}, Max: vg.Point{X: 10, Y: 15}},
+		}
+		if !a.separateAll(boxes) {
+			t.Fatal("separateAll reported no movement for overlapping boxes")
+		}
+		if overlaps(boxes[0], boxes[1]) {
+			t.Errorf("boxes still overlap after separateAll: %v, %v", boxes[0], boxes[1])
+		}
+	})
+
+	t.Run("non-overlapping boxes are left alone", func(t *testing.T) {
+		a := &Annotations{Axis: AxisVertical}
+		boxes := []vg.Rectangle{
+			{Min: vg.Point{X: 0, Y: 0}, Max: vg.Point{X: 10, Y: 10}},
+			{Min: vg.Point{X: 0, Y: 20}, Max: vg.Point{X: 10, Y: 30}},
+		}
+		want := boxes[0]
+		if a.separateAll(boxes) {
+			t.Fatal("separateAll reported movement for non-overlapping boxes")
+		}
+		if boxes[0] != want {
+			t.Errorf("separateAll moved a non-overlapping box: got %v, want %v", boxes[0], want)
+		}
+	})
+}
+
+func TestAnnotationsResolveConverges(t *testing.T) {
+	a := &Annotations{Axis: AxisVertical}
+	bound := vg.Rectangle{Min: vg.Point{X: 0, Y: 0}, Max: vg.Point{X: 100, Y: 100}}
+	boxes := []vg.Rectangle{
+		{Min: vg.Point{X: 0, Y: -5}, Max: vg.Point{X: 10, Y: 5}},
+		{Min: vg.Point{X: 0, Y: -2}, Max: vg.Point{X: 10, Y: 8}},
+		{Min: vg.Point{X: 0, Y: 95}, Max: vg.Point{X: 10, Y: 105}},
+	}
+
+	a.resolve(boxes, bound)
+
+	for i := range boxes {
+		if boxes[i].Min.Y < bound.Min.Y || boxes[i].Max.Y > bound.Max.Y {
+			t.Errorf("box %d escaped bound %v: %v", i, bound, boxes[i])
+		}
+		for j := range boxes {
+			if i != j && overlaps(boxes[i], boxes[j]) {
+				t.Errorf("boxes %d and %d still overlap: %v, %v", i, j, boxes[i], boxes[j])
+			}
+		}
+	}
+}
@@ -0,0 +1,234 @@
+// Copyright ©2015 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/mison201/plot"
+	"github.com/mison201/plot/vg"
+	"github.com/mison201/plot/vg/draw"
+)
+
+// ComparisonSample is a single paired before/after measurement
+// within a ComparisonSeries, such as one benchmark run before and
+// after a change.
+type ComparisonSample struct {
+	Before, After float64
+}
+
+// ComparisonSeries is one labelled group of paired samples drawn as
+// a dot strip in a Comparison chart.
+type ComparisonSeries struct {
+	// Label names the series along the nominal X axis.
+	Label string
+
+	Samples []ComparisonSample
+
+	// Color is used for this series' sample dots, summary bar
+	// and whisker.
+	Color color.Color
+}
+
+// Comparison draws a benchmark-comparison dot chart, of the kind
+// used to visualize before/after distributions: for each series on
+// a nominal X axis, a horizontally jittered strip of individual
+// sample dots, a summary bar at the series' center, and an optional
+// confidence-interval whisker.  A secondary Y axis, if configured,
+// carries a per-series percent-change marker.
+//
+// Comparison implements the plot.Plotter, plot.DataRanger and
+// plot.GlyphBoxer interfaces.
+type Comparison struct {
+	Categories []string
+	Series     []ComparisonSeries
+
+	// LogScale causes sample values to be transformed through
+	// math.Log before being mapped to Y, and the summary bar to
+	// be placed at the geometric, rather than arithmetic, mean.
+	LogScale bool
+
+	// Jitter is the maximum horizontal offset, in data units,
+	// applied to sample dots within their series' strip.
+	Jitter float64
+
+	// DotRadius is the radius of each sample dot.
+	DotRadius vg.Length
+
+	// BarWidth is the width of the summary bar.
+	BarWidth vg.Length
+
+	// WhiskerStyle, if its Width is non-zero, draws a confidence
+	// interval whisker (±1 standard error of the mean) through
+	// the summary bar.
+	WhiskerStyle draw.LineStyle
+
+	// ChangeYAxis is the index, as used with Plot.AddWithAxis, of
+	// the secondary Y axis that per-series percent-change
+	// markers are drawn against.  A negative value (the default)
+	// disables the change series.
+	ChangeYAxis int
+
+	// ChangeColor is used for the percent-change markers.
+	ChangeColor color.Color
+}
+
+// NewComparison returns a new Comparison for the given categories
+// and series, with reasonable default styling, and immediately
+// switches plt's X axis to a nominal axis labelled by categories.
+// This must happen before plt.Draw first lays out the X axis, so
+// it cannot wait until Comparison.Plot runs.
+func NewComparison(plt *plot.Plot, categories []string, series []ComparisonSeries) *Comparison {
+	plt.NominalX(categories...)
+	return &Comparison{
+		Categories:  categories,
+		Series:      series,
+		Jitter:      0.15,
+		DotRadius:   vg.Points(1.5),
+		BarWidth:    vg.Points(12),
+		ChangeYAxis: -1,
+		ChangeColor: color.Gray16{Y: 0x7fff},
+	}
+}
+
+// jitter returns the deterministic horizontal offset, in data
+// units, for the i'th of n samples in a series.
+func (cmp *Comparison) jitter(i, n int) float64 {
+	if n <= 1 {
+		return 0
+	}
+	frac := float64(i)/float64(n-1) - 0.5
+	return frac * 2 * cmp.Jitter
+}
+
+// transform applies the LogScale transform, if any, to v.
+func (cmp *Comparison) transform(v float64) float64 {
+	if cmp.LogScale {
+		return math.Log(v)
+	}
+	return v
+}
+
+// summarize returns the center (the arithmetic mean of the
+// transformed values, which under LogScale is the log of their
+// geometric mean) and standard error of the transformed "after"
+// values in samples.
+func (cmp *Comparison) summarize(samples []ComparisonSample) (center, stderr float64) {
+	n := len(samples)
+	if n == 0 {
+		return 0, 0
+	}
+	var sum float64
+	for _, s := range samples {
+		sum += cmp.transform(s.After)
+	}
+	mean := sum / float64(n)
+	if n < 2 {
+		return mean, 0
+	}
+	var variance float64
+	for _, s := range samples {
+		d := cmp.transform(s.After) - mean
+		variance += d * d
+	}
+	variance /= float64(n - 1)
+	return mean, math.Sqrt(variance / float64(n))
+}
+
+// changePercent returns the mean percent change from Before to
+// After across samples.
+func changePercent(samples []ComparisonSample) float64 {
+	if len(samples) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, s := range samples {
+		sum += (s.After - s.Before) / s.Before
+	}
+	return 100 * sum / float64(len(samples))
+}
+
+// Plot implements the plot.Plotter interface.  The owning plot's X
+// axis must already be nominal, as set up by NewComparison.
+func (cmp *Comparison) Plot(c draw.Canvas, plt *plot.Plot, x, y *plot.Axis) {
+	trX, trY := plt.Transforms(&c, x, y)
+
+	for i, s := range cmp.Series {
+		cx := float64(i)
+		dot := draw.GlyphStyle{Color: s.Color, Radius: cmp.DotRadius, Shape: draw.CircleGlyph{}}
+		for j, sample := range s.Samples {
+			dx := trX(cx + cmp.jitter(j, len(s.Samples)))
+			dot.DrawGlyph(&c, vg.Point{X: dx, Y: trY(cmp.transform(sample.After))})
+		}
+
+		center, stderr := cmp.summarize(s.Samples)
+		bx, by := trX(cx), trY(center)
+		bar := vg.Rectangle{
+			Min: vg.Point{X: bx - cmp.BarWidth/2, Y: by - vg.Points(1)},
+			Max: vg.Point{X: bx + cmp.BarWidth/2, Y: by + vg.Points(1)},
+		}
+		c.SetColor(s.Color)
+		c.Fill(bar.Path())
+
+		if cmp.WhiskerStyle.Width > 0 && stderr > 0 {
+			c.StrokeLine2(cmp.WhiskerStyle, bx, trY(center-stderr), bx, trY(center+stderr))
+		}
+	}
+
+	if cmp.ChangeYAxis < 0 || cmp.ChangeYAxis >= len(plt.Ys) {
+		return
+	}
+	changeY := plt.Ys[cmp.ChangeYAxis]
+	_, trChangeY := plt.Transforms(&c, x, changeY)
+	change := draw.GlyphStyle{Color: cmp.ChangeColor, Radius: cmp.DotRadius, Shape: draw.SquareGlyph{}}
+	for i, s := range cmp.Series {
+		change.DrawGlyph(&c, vg.Point{X: trX(float64(i)), Y: trChangeY(changePercent(s.Samples))})
+	}
+}
+
+// DataRange implements the plot.DataRanger interface.  Only the
+// "after" value of each sample is ever drawn against the primary Y
+// axis (see Plot); Before is used solely to compute the percent
+// change plotted against the secondary ChangeYAxis, so it does not
+// factor into this range.
+func (cmp *Comparison) DataRange() (xmin, xmax, ymin, ymax float64) {
+	xmin, xmax = -0.5, float64(len(cmp.Categories))-0.5
+	ymin, ymax = math.Inf(1), math.Inf(-1)
+	for _, s := range cmp.Series {
+		for _, sample := range s.Samples {
+			after := cmp.transform(sample.After)
+			ymin = math.Min(ymin, after)
+			ymax = math.Max(ymax, after)
+		}
+	}
+	return xmin, xmax, ymin, ymax
+}
+
+// GlyphBoxes implements the plot.GlyphBoxer interface, reserving
+// room for the jittered dot strip and summary bar of each series.
+func (cmp *Comparison) GlyphBoxes(plt *plot.Plot, x, y *plot.Axis) []plot.GlyphBox {
+	var boxes []plot.GlyphBox
+	r := cmp.DotRadius
+	for i, s := range cmp.Series {
+		for j, sample := range s.Samples {
+			boxes = append(boxes, plot.GlyphBox{
+				X:         x.Norm(float64(i) + cmp.jitter(j, len(s.Samples))),
+				Y:         y.Norm(cmp.transform(sample.After)),
+				Rectangle: vg.Rectangle{Min: vg.Point{X: -r, Y: -r}, Max: vg.Point{X: r, Y: r}},
+			})
+		}
+		center, _ := cmp.summarize(s.Samples)
+		boxes = append(boxes, plot.GlyphBox{
+			X: x.Norm(float64(i)),
+			Y: y.Norm(center),
+			Rectangle: vg.Rectangle{
+				Min: vg.Point{X: -cmp.BarWidth / 2, Y: -vg.Points(1)},
+				Max: vg.Point{X: cmp.BarWidth / 2, Y: vg.Points(1)},
+			},
+		})
+	}
+	return boxes
+}
@@ -0,0 +1,116 @@
+// Copyright ©2015 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"math"
+	"testing"
+)
+
+func TestComparisonJitter(t *testing.T) {
+	cmp := &Comparison{Jitter: 0.2}
+	cases := []struct {
+		i, n int
+		want float64
+	}{
+		{i: 0, n: 0, want: 0},
+		{i: 0, n: 1, want: 0},
+		{i: 0, n: 3, want: -0.2},
+		{i: 1, n: 3, want: 0},
+		{i: 2, n: 3, want: 0.2},
+	}
+	for _, c := range cases {
+		if got := cmp.jitter(c.i, c.n); math.Abs(got-c.want) > 1e-9 {
+			t.Errorf("jitter(%d, %d) = %v, want %v", c.i, c.n, got, c.want)
+		}
+	}
+}
+
+func TestComparisonSummarize(t *testing.T) {
+	cases := []struct {
+		name       string
+		logScale   bool
+		samples    []ComparisonSample
+		wantCenter float64
+		wantStderr bool
+	}{
+		{
+			name:       "arithmetic mean of several samples",
+			samples:    []ComparisonSample{{After: 1}, {After: 2}, {After: 3}},
+			wantCenter: 2,
+			wantStderr: true,
+		},
+		{
+			name:       "single sample has zero standard error",
+			samples:    []ComparisonSample{{After: 5}},
+			wantCenter: 5,
+		},
+		{
+			name:    "empty series",
+			samples: nil,
+		},
+		{
+			name:       "log scale averages the logs",
+			logScale:   true,
+			samples:    []ComparisonSample{{After: 1}, {After: math.E}},
+			wantCenter: 0.5,
+			wantStderr: true,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			cmp := &Comparison{LogScale: c.logScale}
+			center, stderr := cmp.summarize(c.samples)
+			if math.Abs(center-c.wantCenter) > 1e-9 {
+				t.Errorf("summarize() center = %v, want %v", center, c.wantCenter)
+			}
+			if c.wantStderr && stderr <= 0 {
+				t.Errorf("summarize() stderr = %v, want > 0", stderr)
+			}
+			if !c.wantStderr && stderr != 0 {
+				t.Errorf("summarize() stderr = %v, want 0", stderr)
+			}
+		})
+	}
+}
+
+func TestChangePercent(t *testing.T) {
+	cases := []struct {
+		name    string
+		samples []ComparisonSample
+		want    float64
+	}{
+		{
+			name:    "no change",
+			samples: []ComparisonSample{{Before: 10, After: 10}},
+			want:    0,
+		},
+		{
+			name:    "doubling is +100%",
+			samples: []ComparisonSample{{Before: 10, After: 20}},
+			want:    100,
+		},
+		{
+			name: "mean across samples",
+			samples: []ComparisonSample{
+				{Before: 10, After: 20},
+				{Before: 10, After: 10},
+			},
+			want: 50,
+		},
+		{
+			name:    "empty series",
+			samples: nil,
+			want:    0,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := changePercent(c.samples); math.Abs(got-c.want) > 1e-9 {
+				t.Errorf("changePercent(%v) = %v, want %v", c.samples, got, c.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright ©2015 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"github.com/mison201/plot"
+	"github.com/mison201/plot/vg"
+	"github.com/mison201/plot/vg/draw"
+)
+
+// InsetRect places an Inset's child plot within the parent's data
+// area, in coordinates normalized to that area: (0,0) is the
+// bottom-left corner and (1,1) is the top-right.
+type InsetRect struct {
+	X, Y, W, H float64
+}
+
+// ConnectorRect is a rectangle, in the parent plot's data
+// coordinates, that an Inset draws connector lines from to the
+// corners of its frame — typically the region of the parent plot
+// that the inset is a zoomed-in view of.
+type ConnectorRect struct {
+	XMin, XMax, YMin, YMax float64
+}
+
+// Inset embeds a child plot inside the data area of a parent plot,
+// at a user-specified normalized rectangle.
+//
+// Inset implements the plot.Plotter interface.  It does not
+// implement plot.DataRanger; the child plot's data range has no
+// effect on the parent's axes.
+type Inset struct {
+	// Child is the child plot drawn inside the inset frame.
+	Child *plot.Plot
+
+	// Rect places Child within the parent's data canvas.
+	Rect InsetRect
+
+	// Connector, if non-nil, is the region of the parent's data
+	// space that connector lines are drawn from, to the corners
+	// of the inset's frame, for a zoom-in callout.
+	Connector *ConnectorRect
+
+	// FrameLineStyle draws the connector lines and the border
+	// around the inset's frame.  A zero Width disables both.
+	FrameLineStyle draw.LineStyle
+}
+
+// NewInset returns a new Inset embedding child at rect.
+func NewInset(child *plot.Plot, rect InsetRect) *Inset {
+	return &Inset{Child: child, Rect: rect}
+}
+
+// Plot implements the plot.Plotter interface.
+func (in *Inset) Plot(c draw.Canvas, plt *plot.Plot, x, y *plot.Axis) {
+	size := c.Size()
+	min := vg.Point{
+		X: c.Min.X + vg.Length(in.Rect.X)*size.X,
+		Y: c.Min.Y + vg.Length(in.Rect.Y)*size.Y,
+	}
+	frame := draw.Canvas{
+		Canvas: c.Canvas,
+		Rectangle: vg.Rectangle{
+			Min: min,
+			Max: vg.Point{
+				X: min.X + vg.Length(in.Rect.W)*size.X,
+				Y: min.Y + vg.Length(in.Rect.H)*size.Y,
+			},
+		},
+	}
+
+	if in.FrameLineStyle.Width > 0 {
+		if in.Connector != nil {
+			in.drawConnectors(c, plt, x, y, frame)
+		}
+		c.SetLineStyle(in.FrameLineStyle)
+		c.Stroke(frame.Rectangle.Path())
+	}
+
+	// Draw the child without its background fill, which would
+	// otherwise cover whatever the parent has already drawn
+	// inside the inset frame.
+	bg := in.Child.BackgroundColor
+	in.Child.BackgroundColor = nil
+	in.Child.Draw(frame)
+	in.Child.BackgroundColor = bg
+}
+
+// drawConnectors draws a line from each corner of in.Connector, in
+// the parent's data coordinates, to the matching corner of frame.
+func (in *Inset) drawConnectors(c draw.Canvas, plt *plot.Plot, x, y *plot.Axis, frame draw.Canvas) {
+	trX, trY := plt.Transforms(&c, x, y)
+	corners := [4]vg.Point{
+		{X: trX(in.Connector.XMin), Y: trY(in.Connector.YMin)},
+		{X: trX(in.Connector.XMin), Y: trY(in.Connector.YMax)},
+		{X: trX(in.Connector.XMax), Y: trY(in.Connector.YMax)},
+		{X: trX(in.Connector.XMax), Y: trY(in.Connector.YMin)},
+	}
+	frameCorners := [4]vg.Point{
+		frame.Min,
+		{X: frame.Min.X, Y: frame.Max.Y},
+		frame.Max,
+		{X: frame.Max.X, Y: frame.Min.Y},
+	}
+
+	c.SetLineStyle(in.FrameLineStyle)
+	c.Stroke(vg.Rectangle{Min: corners[0], Max: corners[2]}.Path())
+	for i, corner := range corners {
+		c.StrokeLine2(in.FrameLineStyle, corner.X, corner.Y, frameCorners[i].X, frameCorners[i].Y)
+	}
+}